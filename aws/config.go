@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// Config holds the settings gathered from the provider block that are
+// needed to build an AWSClient.
+type Config struct {
+	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
+
+	// SkipDlmDefaultRoleCreation disables the provider's automatic creation
+	// of the AWS-managed default DLM service role when execution_role_arn is
+	// omitted from an aws_dlm_lifecycle_policy or aws_dlm_default_policy.
+	SkipDlmDefaultRoleCreation bool
+}
+
+// AWSClient is the set of service connections and resolved provider
+// settings made available to resources via the meta argument.
+type AWSClient struct {
+	dlmconn *dlm.DLM
+	ec2conn *ec2.EC2
+	iamconn *iam.IAM
+
+	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
+
+	SkipDlmDefaultRoleCreation bool
+}
+
+// Client builds an AWSClient from the resolved provider configuration.
+func (c *Config) Client() (interface{}, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	client := &AWSClient{
+		dlmconn: dlm.New(sess),
+		ec2conn: ec2.New(sess),
+		iamconn: iam.New(sess),
+
+		IgnoreTagsConfig:           c.IgnoreTagsConfig,
+		SkipDlmDefaultRoleCreation: c.SkipDlmDefaultRoleCreation,
+	}
+
+	return client, nil
+}
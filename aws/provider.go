@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider returns the schema.Provider for the aws package's resources.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"skip_dlm_default_role_creation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip automatic creation of the AWS-managed default DLM service role when execution_role_arn is omitted from a DLM policy resource.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_dlm_lifecycle_policy": resourceAwsDlmLifecyclePolicy(),
+			"aws_dlm_default_policy":   resourceAwsDlmDefaultPolicy(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := Config{
+		SkipDlmDefaultRoleCreation: d.Get("skip_dlm_default_role_creation").(bool),
+	}
+
+	return config.Client()
+}
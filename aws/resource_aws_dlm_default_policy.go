@@ -0,0 +1,347 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsDlmDefaultPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDlmDefaultPolicyCreate,
+		Read:   resourceAwsDlmDefaultPolicyRead,
+		Update: resourceAwsDlmDefaultPolicyUpdate,
+		Delete: resourceAwsDlmDefaultPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9A-Za-z _-]+$"), "see https://docs.aws.amazon.com/cli/latest/reference/dlm/create-lifecycle-policy.html"),
+			},
+			"execution_role_arn": {
+				// When omitted, the AWS-managed default DLM service role is looked up
+				// (creating it if necessary) and its ARN is stored here.
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"default_policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					dlm.DefaultPolicyTypeValuesVolume,
+					dlm.DefaultPolicyTypeValuesInstance,
+				}, false),
+			},
+			"exclusions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclude_boot_volumes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"exclude_volume_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exclude_tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"extend_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"retain_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"cross_region_copy_targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  dlm.SettablePolicyStateValuesEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					dlm.SettablePolicyStateValuesDisabled,
+					dlm.SettablePolicyStateValuesEnabled,
+				}, false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDlmDefaultPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	executionRoleArn := d.Get("execution_role_arn").(string)
+	if executionRoleArn == "" {
+		if meta.(*AWSClient).SkipDlmDefaultRoleCreation {
+			return fmt.Errorf("execution_role_arn is required when the provider's skip_dlm_default_role_creation option is enabled")
+		}
+
+		roleArn, err := ensureDlmDefaultServiceRole(dlmDefaultPolicyRoleType(d), false, meta)
+		if err != nil {
+			return err
+		}
+		executionRoleArn = roleArn
+		d.Set("execution_role_arn", roleArn)
+	}
+
+	input := dlm.CreateLifecyclePolicyInput{
+		Description:      aws.String(d.Get("description").(string)),
+		ExecutionRoleArn: aws.String(executionRoleArn),
+		DefaultPolicy:    aws.String(d.Get("default_policy").(string)),
+		CopyTags:         aws.Bool(d.Get("copy_tags").(bool)),
+		ExtendDeletion:   aws.Bool(d.Get("extend_deletion").(bool)),
+		State:            aws.String(d.Get("state").(string)),
+	}
+
+	if v, ok := d.GetOk("retain_interval"); ok {
+		input.RetainInterval = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("create_interval"); ok {
+		input.CreateInterval = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("cross_region_copy_targets"); ok {
+		input.CrossRegionCopyTargets = expandDlmDefaultPolicyCrossRegionCopyTargets(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("exclusions"); ok {
+		input.Exclusions = expandDlmDefaultPolicyExclusions(v.([]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().DlmTags()
+	}
+
+	log.Printf("[INFO] Creating DLM default lifecycle policy: %s", input)
+	out, err := conn.CreateLifecyclePolicy(&input)
+	if err != nil {
+		return fmt.Errorf("error creating DLM Default Lifecycle Policy: %s", err)
+	}
+
+	d.SetId(*out.PolicyId)
+
+	return resourceAwsDlmDefaultPolicyRead(d, meta)
+}
+
+func resourceAwsDlmDefaultPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	log.Printf("[INFO] Reading DLM default lifecycle policy: %s", d.Id())
+	out, err := conn.GetLifecyclePolicy(&dlm.GetLifecyclePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, dlm.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] DLM Default Lifecycle Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DLM Default Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.Policy.PolicyArn)
+	d.Set("description", out.Policy.Description)
+	d.Set("execution_role_arn", out.Policy.ExecutionRoleArn)
+	d.Set("state", out.Policy.State)
+	d.Set("default_policy", out.Policy.DefaultPolicy)
+	d.Set("copy_tags", out.Policy.CopyTags)
+	d.Set("extend_deletion", out.Policy.ExtendDeletion)
+	d.Set("retain_interval", out.Policy.RetainInterval)
+	d.Set("create_interval", out.Policy.CreateInterval)
+	d.Set("cross_region_copy_targets", flattenDlmDefaultPolicyCrossRegionCopyTargets(out.Policy.CrossRegionCopyTargets))
+	if err := d.Set("exclusions", flattenDlmDefaultPolicyExclusions(out.Policy.Exclusions)); err != nil {
+		return fmt.Errorf("error setting exclusions: %s", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.DlmKeyValueTags(out.Policy.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDlmDefaultPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	input := dlm.UpdateLifecyclePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	}
+	updateLifecyclePolicy := false
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+		updateLifecyclePolicy = true
+	}
+	if d.HasChange("execution_role_arn") {
+		input.ExecutionRoleArn = aws.String(d.Get("execution_role_arn").(string))
+		updateLifecyclePolicy = true
+	}
+	if d.HasChange("state") {
+		input.State = aws.String(d.Get("state").(string))
+		updateLifecyclePolicy = true
+	}
+	if d.HasChange("copy_tags") {
+		input.CopyTags = aws.Bool(d.Get("copy_tags").(bool))
+		updateLifecyclePolicy = true
+	}
+	if d.HasChange("extend_deletion") {
+		input.ExtendDeletion = aws.Bool(d.Get("extend_deletion").(bool))
+		updateLifecyclePolicy = true
+	}
+	if d.HasChange("retain_interval") {
+		input.RetainInterval = aws.Int64(int64(d.Get("retain_interval").(int)))
+		updateLifecyclePolicy = true
+	}
+	if d.HasChange("create_interval") {
+		input.CreateInterval = aws.Int64(int64(d.Get("create_interval").(int)))
+		updateLifecyclePolicy = true
+	}
+	if d.HasChange("cross_region_copy_targets") {
+		input.CrossRegionCopyTargets = expandDlmDefaultPolicyCrossRegionCopyTargets(d.Get("cross_region_copy_targets").([]interface{}))
+		updateLifecyclePolicy = true
+	}
+	if d.HasChange("exclusions") {
+		input.Exclusions = expandDlmDefaultPolicyExclusions(d.Get("exclusions").([]interface{}))
+		updateLifecyclePolicy = true
+	}
+
+	if updateLifecyclePolicy {
+		log.Printf("[INFO] Updating DLM default lifecycle policy %s", d.Id())
+		_, err := conn.UpdateLifecyclePolicy(&input)
+		if err != nil {
+			return fmt.Errorf("error updating DLM Default Lifecycle Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := keyvaluetags.DlmUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourceAwsDlmDefaultPolicyRead(d, meta)
+}
+
+func resourceAwsDlmDefaultPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	log.Printf("[INFO] Deleting DLM default lifecycle policy: %s", d.Id())
+	_, err := conn.DeleteLifecyclePolicy(&dlm.DeleteLifecyclePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting DLM Default Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// dlmDefaultPolicyRoleType maps the default_policy attribute (VOLUME/INSTANCE)
+// to the policy type used to pick the AWS-managed default service role.
+func dlmDefaultPolicyRoleType(d *schema.ResourceData) string {
+	if d.Get("default_policy").(string) == dlm.DefaultPolicyTypeValuesInstance {
+		return dlm.PolicyTypeValuesImageManagement
+	}
+
+	return dlm.PolicyTypeValuesEbsSnapshotManagement
+}
+
+func expandDlmDefaultPolicyExclusions(cfg []interface{}) *dlm.Exclusions {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	exclusions := &dlm.Exclusions{}
+	if v, ok := m["exclude_boot_volumes"]; ok {
+		exclusions.ExcludeBootVolumes = aws.Bool(v.(bool))
+	}
+	if v, ok := m["exclude_volume_types"]; ok {
+		exclusions.ExcludeVolumeTypes = expandStringList(v.([]interface{}))
+	}
+	if v, ok := m["exclude_tags"]; ok {
+		exclusions.ExcludeTags = expandDlmTags(v.(map[string]interface{}))
+	}
+
+	return exclusions
+}
+
+func flattenDlmDefaultPolicyExclusions(exclusions *dlm.Exclusions) []map[string]interface{} {
+	if exclusions == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	result["exclude_boot_volumes"] = aws.BoolValue(exclusions.ExcludeBootVolumes)
+	result["exclude_volume_types"] = flattenStringList(exclusions.ExcludeVolumeTypes)
+	result["exclude_tags"] = flattenDlmTags(exclusions.ExcludeTags)
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmDefaultPolicyCrossRegionCopyTargets(cfg []interface{}) []*dlm.CrossRegionCopyTarget {
+	targets := make([]*dlm.CrossRegionCopyTarget, len(cfg))
+	for i, c := range cfg {
+		targets[i] = &dlm.CrossRegionCopyTarget{
+			TargetRegion: aws.String(c.(string)),
+		}
+	}
+
+	return targets
+}
+
+func flattenDlmDefaultPolicyCrossRegionCopyTargets(targets []*dlm.CrossRegionCopyTarget) []string {
+	result := make([]string, len(targets))
+	for i, t := range targets {
+		result[i] = aws.StringValue(t.TargetRegion)
+	}
+
+	return result
+}
@@ -1,17 +1,43 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+// dlmMinScheduleInterval is the minimum time DLM allows between two
+// consecutive fires of a schedule's create_rule.
+const dlmMinScheduleInterval = 1 * time.Hour
+
+// dlmMaxSchedulesPerPolicy mirrors the DLM service limit on schedules per policy.
+const dlmMaxSchedulesPerPolicy = 4
+
+// dlmCronLookaheadDays bounds how far into the future cron expressions are
+// simulated when looking for fire times, so an unsatisfiable expression
+// fails fast instead of looping indefinitely.
+const dlmCronLookaheadDays = 3660
+
+const (
+	dlmDefaultRoleNameEbs        = "AWSDataLifecycleManagerDefaultRole"
+	dlmDefaultRoleNameImage      = "AWSDataLifecycleManagerDefaultRoleForAMIManagement"
+	dlmDefaultRolePolicyArnEbs   = "arn:aws:iam::aws:policy/service-role/AWSDataLifecycleManagerServiceRole"
+	dlmDefaultRolePolicyArnImage = "arn:aws:iam::aws:policy/service-role/AWSDataLifecycleManagerServiceRoleForAMIManagement"
+	dlmSsmFullAccessPolicyArn    = "arn:aws:iam::aws:policy/AWSDataLifecycleManagerSSMFullAccess"
+)
+
 func resourceAwsDlmLifecyclePolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsDlmLifecyclePolicyCreate,
@@ -21,6 +47,7 @@ func resourceAwsDlmLifecyclePolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAwsDlmLifecyclePolicyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -34,27 +61,158 @@ func resourceAwsDlmLifecyclePolicy() *schema.Resource {
 				//	TODO: https://docs.aws.amazon.com/dlm/latest/APIReference/API_LifecyclePolicy.html#dlm-Type-LifecyclePolicy-Description says it has max length of 500 but doesn't mention the regex but SDK and CLI docs only mention the regex and not max length. Check this
 			},
 			"execution_role_arn": {
-				// TODO: Make this not required and if it's not provided then use the default service role, creating it if necessary
+				// When omitted, the AWS-managed default DLM service role is looked up
+				// (creating it if necessary) and its ARN is stored here.
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validateArn,
 			},
+			"default_role_policy_type": {
+				// Disambiguates which AWS-managed default service role to look up or
+				// create when execution_role_arn is omitted.
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  dlm.PolicyTypeValuesEbsSnapshotManagement,
+				ValidateFunc: validation.StringInSlice([]string{
+					dlm.PolicyTypeValuesEbsSnapshotManagement,
+					dlm.PolicyTypeValuesImageManagement,
+				}, false),
+			},
 			"policy_details": {
 				Type:     schema.TypeList,
 				Required: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"policy_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  dlm.PolicyTypeValuesEbsSnapshotManagement,
+							ValidateFunc: validation.StringInSlice([]string{
+								dlm.PolicyTypeValuesEbsSnapshotManagement,
+								dlm.PolicyTypeValuesImageManagement,
+								dlm.PolicyTypeValuesEventBasedPolicy,
+							}, false),
+						},
 						"resource_types": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"event_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											dlm.EventSourceValuesManagedCwe,
+										}, false),
+									},
+									"parameters": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"description_regex": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"event_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														dlm.EventTypeValuesShareSnapshot,
+													}, false),
+												},
+												"snapshot_owner": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"cross_region_copy": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"encryption_configuration": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"cmk_arn": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validateArn,
+															},
+															"encrypted": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
+												"retain_rule": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"interval": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"interval_unit": {
+																Type:     schema.TypeString,
+																Required: true,
+																ValidateFunc: validation.StringInSlice([]string{
+																	dlm.RetentionIntervalUnitValuesDays,
+																	dlm.RetentionIntervalUnitValuesWeeks,
+																	dlm.RetentionIntervalUnitValuesMonths,
+																	dlm.RetentionIntervalUnitValuesYears,
+																}, false),
+															},
+														},
+													},
+												},
+												"target": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"schedule": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MinItems: 1,
-							MaxItems: 1,
+							MaxItems: 4,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"copy_tags": {
@@ -100,6 +258,58 @@ func resourceAwsDlmLifecyclePolicy() *schema.Resource {
 														ValidateFunc: validation.StringMatch(regexp.MustCompile("^(0[0-9]|1[0-9]|2[0-3]):[0-5][0-9]$"), "see https://docs.aws.amazon.com/dlm/latest/APIReference/API_CreateRule.html#dlm-Type-CreateRule-Times"),
 													},
 												},
+												"no_reboot": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"script": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"execution_handler": {
+																// Accepts an SSM document ARN, or one of the convenience
+																// values AWS_VSS_BACKUP or AWSSystemsManagerSAP-CreateDLMSnapshotForSAPHANA.
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"execution_handler_service": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Default:  dlm.ExecutionHandlerServiceValuesAwsSystemsManager,
+																ValidateFunc: validation.StringInSlice([]string{
+																	dlm.ExecutionHandlerServiceValuesAwsSystemsManager,
+																}, false),
+															},
+															"execute_operation_on_script_failure": {
+																Type:     schema.TypeBool,
+																Optional: true,
+															},
+															"execution_timeout": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ValidateFunc: validation.IntBetween(10, 120),
+															},
+															"maximum_retry_count": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ValidateFunc: validation.IntBetween(0, 3),
+															},
+															"stages": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																	ValidateFunc: validation.StringInSlice([]string{
+																		dlm.StageValuesPre,
+																		dlm.StageValuesPost,
+																	}, false),
+																},
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -164,9 +374,150 @@ func resourceAwsDlmLifecyclePolicy() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"count": {
-													Type:         schema.TypeInt,
-													Required:     true,
-													ValidateFunc: validation.IntBetween(1, 1000),
+													Type:          schema.TypeInt,
+													Optional:      true,
+													ConflictsWith: []string{"policy_details.0.schedule.0.retain_rule.0.interval", "policy_details.0.schedule.0.retain_rule.0.interval_unit"},
+													ExactlyOneOf:  []string{"policy_details.0.schedule.0.retain_rule.0.count", "policy_details.0.schedule.0.retain_rule.0.interval"},
+													ValidateFunc:  validation.IntBetween(1, 1000),
+												},
+												"interval": {
+													Type:          schema.TypeInt,
+													Optional:      true,
+													ConflictsWith: []string{"policy_details.0.schedule.0.retain_rule.0.count"},
+													ExactlyOneOf:  []string{"policy_details.0.schedule.0.retain_rule.0.count", "policy_details.0.schedule.0.retain_rule.0.interval"},
+													ValidateFunc:  validation.IntAtLeast(1),
+												},
+												"interval_unit": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ConflictsWith: []string{"policy_details.0.schedule.0.retain_rule.0.count"},
+													ValidateFunc: validation.StringInSlice([]string{
+														dlm.RetentionIntervalUnitValuesDays,
+														dlm.RetentionIntervalUnitValuesWeeks,
+														dlm.RetentionIntervalUnitValuesMonths,
+														dlm.RetentionIntervalUnitValuesYears,
+													}, false),
+												},
+											},
+										},
+									},
+									"archive_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"retain_rule": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"retention_archive_tier": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"count": {
+																			Type:          schema.TypeInt,
+																			Optional:      true,
+																			ConflictsWith: []string{"policy_details.0.schedule.0.archive_rule.0.retain_rule.0.retention_archive_tier.0.interval", "policy_details.0.schedule.0.archive_rule.0.retain_rule.0.retention_archive_tier.0.interval_unit"},
+																			ExactlyOneOf:  []string{"policy_details.0.schedule.0.archive_rule.0.retain_rule.0.retention_archive_tier.0.count", "policy_details.0.schedule.0.archive_rule.0.retain_rule.0.retention_archive_tier.0.interval"},
+																			ValidateFunc:  validation.IntBetween(1, 1000),
+																		},
+																		"interval": {
+																			Type:          schema.TypeInt,
+																			Optional:      true,
+																			ConflictsWith: []string{"policy_details.0.schedule.0.archive_rule.0.retain_rule.0.retention_archive_tier.0.count"},
+																			ExactlyOneOf:  []string{"policy_details.0.schedule.0.archive_rule.0.retain_rule.0.retention_archive_tier.0.count", "policy_details.0.schedule.0.archive_rule.0.retain_rule.0.retention_archive_tier.0.interval"},
+																			ValidateFunc:  validation.IntAtLeast(1),
+																		},
+																		"interval_unit": {
+																			Type:          schema.TypeString,
+																			Optional:      true,
+																			ConflictsWith: []string{"policy_details.0.schedule.0.archive_rule.0.retain_rule.0.retention_archive_tier.0.count"},
+																			ValidateFunc: validation.StringInSlice([]string{
+																				dlm.RetentionIntervalUnitValuesDays,
+																				dlm.RetentionIntervalUnitValuesWeeks,
+																				dlm.RetentionIntervalUnitValuesMonths,
+																				dlm.RetentionIntervalUnitValuesYears,
+																			}, false),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"fast_restore_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"availability_zones": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"count": {
+													Type:          schema.TypeInt,
+													Optional:      true,
+													ConflictsWith: []string{"policy_details.0.schedule.0.fast_restore_rule.0.interval", "policy_details.0.schedule.0.fast_restore_rule.0.interval_unit"},
+													ValidateFunc:  validation.IntBetween(1, 1000),
+												},
+												"interval": {
+													Type:          schema.TypeInt,
+													Optional:      true,
+													ConflictsWith: []string{"policy_details.0.schedule.0.fast_restore_rule.0.count"},
+													ValidateFunc:  validation.IntAtLeast(1),
+												},
+												"interval_unit": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ConflictsWith: []string{"policy_details.0.schedule.0.fast_restore_rule.0.count"},
+													ValidateFunc: validation.StringInSlice([]string{
+														dlm.RetentionIntervalUnitValuesDays,
+														dlm.RetentionIntervalUnitValuesWeeks,
+														dlm.RetentionIntervalUnitValuesMonths,
+														dlm.RetentionIntervalUnitValuesYears,
+													}, false),
+												},
+											},
+										},
+									},
+									"deprecate_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"count": {
+													Type:          schema.TypeInt,
+													Optional:      true,
+													ConflictsWith: []string{"policy_details.0.schedule.0.deprecate_rule.0.interval", "policy_details.0.schedule.0.deprecate_rule.0.interval_unit"},
+													ValidateFunc:  validation.IntBetween(1, 1000),
+												},
+												"interval": {
+													Type:          schema.TypeInt,
+													Optional:      true,
+													ConflictsWith: []string{"policy_details.0.schedule.0.deprecate_rule.0.count"},
+													ValidateFunc:  validation.IntAtLeast(1),
+												},
+												"interval_unit": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ConflictsWith: []string{"policy_details.0.schedule.0.deprecate_rule.0.count"},
+													ValidateFunc: validation.StringInSlice([]string{
+														dlm.RetentionIntervalUnitValuesDays,
+														dlm.RetentionIntervalUnitValuesWeeks,
+														dlm.RetentionIntervalUnitValuesMonths,
+														dlm.RetentionIntervalUnitValuesYears,
+													}, false),
 												},
 											},
 										},
@@ -181,7 +532,7 @@ func resourceAwsDlmLifecyclePolicy() *schema.Resource {
 						},
 						"target_tags": {
 							Type:     schema.TypeMap,
-							Required: true,
+							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 					},
@@ -204,9 +555,34 @@ func resourceAwsDlmLifecyclePolicy() *schema.Resource {
 func resourceAwsDlmLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dlmconn
 
+	if err := validateDlmCreateRuleScripts(d); err != nil {
+		return err
+	}
+	if err := validateDlmDeprecateRule(d); err != nil {
+		return err
+	}
+	if err := validateDlmFastRestoreAvailabilityZones(d, meta); err != nil {
+		return err
+	}
+
+	executionRoleArn := d.Get("execution_role_arn").(string)
+	if executionRoleArn == "" {
+		if meta.(*AWSClient).SkipDlmDefaultRoleCreation {
+			return fmt.Errorf("execution_role_arn is required when the provider's skip_dlm_default_role_creation option is enabled")
+		}
+
+		needsSsmAccess := len(d.Get("policy_details.0.schedule.0.create_rule.0.script").([]interface{})) > 0
+		roleArn, err := ensureDlmDefaultServiceRole(d.Get("default_role_policy_type").(string), needsSsmAccess, meta)
+		if err != nil {
+			return err
+		}
+		executionRoleArn = roleArn
+		d.Set("execution_role_arn", roleArn)
+	}
+
 	input := dlm.CreateLifecyclePolicyInput{
 		Description:      aws.String(d.Get("description").(string)),
-		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		ExecutionRoleArn: aws.String(executionRoleArn),
 		PolicyDetails:    expandDlmPolicyDetails(d.Get("policy_details").([]interface{})),
 		State:            aws.String(d.Get("state").(string)),
 	}
@@ -263,6 +639,16 @@ func resourceAwsDlmLifecyclePolicyRead(d *schema.ResourceData, meta interface{})
 func resourceAwsDlmLifecyclePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dlmconn
 
+	if err := validateDlmCreateRuleScripts(d); err != nil {
+		return err
+	}
+	if err := validateDlmDeprecateRule(d); err != nil {
+		return err
+	}
+	if err := validateDlmFastRestoreAvailabilityZones(d, meta); err != nil {
+		return err
+	}
+
 	input := dlm.UpdateLifecyclePolicyInput{
 		PolicyId: aws.String(d.Id()),
 	}
@@ -317,42 +703,721 @@ func resourceAwsDlmLifecyclePolicyDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func expandDlmPolicyDetails(cfg []interface{}) *dlm.PolicyDetails {
-	if len(cfg) == 0 || cfg[0] == nil {
-		return nil
+// validateDlmCreateRuleScripts rejects configurations that set a pre/post
+// script on a schedule whose policy applies to resources other than
+// EC2 instances, since DLM only runs Scripts against INSTANCE resources.
+func validateDlmCreateRuleScripts(d *schema.ResourceData) error {
+	resourceTypes := d.Get("policy_details.0.resource_types").([]interface{})
+	schedules := d.Get("policy_details.0.schedule").([]interface{})
+
+	for i := range schedules {
+		path := fmt.Sprintf("policy_details.0.schedule.%d.create_rule.0.script", i)
+		scripts := d.Get(path).([]interface{})
+		if len(scripts) == 0 {
+			continue
+		}
+
+		for _, v := range resourceTypes {
+			if v.(string) != dlm.ResourceTypeValuesInstance {
+				return fmt.Errorf("%s is only valid when policy_details.0.resource_types is %q", path, dlm.ResourceTypeValuesInstance)
+			}
+		}
 	}
 
-	policyDetails := &dlm.PolicyDetails{}
-	m := cfg[0].(map[string]interface{})
-	if v, ok := m["resource_types"]; ok {
-		policyDetails.ResourceTypes = expandStringList(v.([]interface{}))
+	return nil
+}
+
+// ensureDlmDefaultServiceRole looks up the AWS-managed default DLM service
+// role for policyType (EBS_SNAPSHOT_MANAGEMENT or IMAGE_MANAGEMENT), creating
+// it (and attaching the SSM managed policy when needsSsmAccess is set) if it
+// doesn't already exist, and returns its ARN.
+func ensureDlmDefaultServiceRole(policyType string, needsSsmAccess bool, meta interface{}) (string, error) {
+	conn := meta.(*AWSClient).iamconn
+
+	roleName := dlmDefaultRoleNameEbs
+	managedPolicyArn := dlmDefaultRolePolicyArnEbs
+	if policyType == dlm.PolicyTypeValuesImageManagement {
+		roleName = dlmDefaultRoleNameImage
+		managedPolicyArn = dlmDefaultRolePolicyArnImage
 	}
-	if v, ok := m["schedule"]; ok {
-		policyDetails.Schedules = expandDlmSchedules(v.([]interface{}))
+
+	out, err := conn.GetRole(&iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err == nil {
+		return aws.StringValue(out.Role.Arn), nil
 	}
-	if v, ok := m["target_tags"]; ok {
-		policyDetails.TargetTags = expandDlmTags(v.(map[string]interface{}))
+	if !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return "", fmt.Errorf("error reading IAM Role (%s): %s", roleName, err)
 	}
 
-	return policyDetails
-}
+	assumeRolePolicy, err := (&IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{
+				Effect:  "Allow",
+				Actions: []string{"sts:AssumeRole"},
+				Principals: []IAMPolicyStatementPrincipal{
+					{
+						Type:        "Service",
+						Identifiers: []string{"dlm.amazonaws.com"},
+					},
+				},
+			},
+		},
+	}).Marshal()
+	if err != nil {
+		return "", fmt.Errorf("error building DLM default service role assume role policy: %s", err)
+	}
 
-func flattenDlmPolicyDetails(policyDetails *dlm.PolicyDetails) []map[string]interface{} {
-	result := make(map[string]interface{})
-	result["resource_types"] = flattenStringList(policyDetails.ResourceTypes)
-	result["schedule"] = flattenDlmSchedules(policyDetails.Schedules)
-	result["target_tags"] = flattenDlmTags(policyDetails.TargetTags)
+	createOut, err := conn.CreateRole(&iam.CreateRoleInput{
+		RoleName:                 aws.String(roleName),
+		AssumeRolePolicyDocument: aws.String(assumeRolePolicy),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating IAM Role (%s): %s", roleName, err)
+	}
 
-	return []map[string]interface{}{result}
+	if _, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+		RoleName:  aws.String(roleName),
+		PolicyArn: aws.String(managedPolicyArn),
+	}); err != nil {
+		return "", fmt.Errorf("error attaching policy to IAM Role (%s): %s", roleName, err)
+	}
+
+	if needsSsmAccess {
+		if _, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+			RoleName:  aws.String(roleName),
+			PolicyArn: aws.String(dlmSsmFullAccessPolicyArn),
+		}); err != nil {
+			return "", fmt.Errorf("error attaching SSM policy to IAM Role (%s): %s", roleName, err)
+		}
+	}
+
+	return aws.StringValue(createOut.Role.Arn), nil
 }
 
-func expandDlmSchedules(cfg []interface{}) []*dlm.Schedule {
-	schedules := make([]*dlm.Schedule, len(cfg))
-	for i, c := range cfg {
-		schedule := &dlm.Schedule{}
-		m := c.(map[string]interface{})
-		if v, ok := m["copy_tags"]; ok {
-			schedule.CopyTags = aws.Bool(v.(bool))
+// validateDlmDeprecateRule rejects a deprecate_rule on schedules whose
+// policy isn't managing AMIs, since DLM only deprecates images.
+func validateDlmDeprecateRule(d *schema.ResourceData) error {
+	policyType := d.Get("policy_details.0.policy_type").(string)
+	schedules := d.Get("policy_details.0.schedule").([]interface{})
+
+	for i := range schedules {
+		path := fmt.Sprintf("policy_details.0.schedule.%d.deprecate_rule", i)
+		deprecateRule := d.Get(path).([]interface{})
+		if len(deprecateRule) == 0 {
+			continue
+		}
+
+		if policyType != dlm.PolicyTypeValuesImageManagement {
+			return fmt.Errorf("%s is only valid when policy_details.0.policy_type is %q", path, dlm.PolicyTypeValuesImageManagement)
+		}
+	}
+
+	return nil
+}
+
+// validateDlmFastRestoreAvailabilityZones checks that every AZ named in a
+// fast_restore_rule actually exists in the provider's configured region.
+func validateDlmFastRestoreAvailabilityZones(d *schema.ResourceData, meta interface{}) error {
+	schedules := d.Get("policy_details.0.schedule").([]interface{})
+
+	var valid map[string]bool
+
+	for i := range schedules {
+		path := fmt.Sprintf("policy_details.0.schedule.%d.fast_restore_rule.0.availability_zones", i)
+		cfg := d.Get(path).([]interface{})
+		if len(cfg) == 0 {
+			continue
+		}
+
+		if valid == nil {
+			conn := meta.(*AWSClient).ec2conn
+			out, err := conn.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{})
+			if err != nil {
+				return fmt.Errorf("error describing Availability Zones: %s", err)
+			}
+
+			valid = make(map[string]bool, len(out.AvailabilityZones))
+			for _, az := range out.AvailabilityZones {
+				valid[aws.StringValue(az.ZoneName)] = true
+			}
+		}
+
+		for _, v := range cfg {
+			if !valid[v.(string)] {
+				return fmt.Errorf("%s: %q is not an Availability Zone in this region", path, v.(string))
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsDlmLifecyclePolicyCustomizeDiff runs a set of offline,
+// plan-time-only sanity checks that the DLM API would otherwise only
+// reject at apply time: cron expressions that are unsatisfiable or fire
+// more often than the service allows, cross_region_copy_rule retention
+// windows shorter than the schedule's own retain_rule, duplicate
+// target_region values, missing cmk_arn on cross-region encrypted copies,
+// and too many schedules on a single policy.
+func resourceAwsDlmLifecyclePolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateDlmPolicyTypeRequiredFields(d); err != nil {
+		return err
+	}
+
+	schedules := d.Get("policy_details.0.schedule").([]interface{})
+	if len(schedules) > dlmMaxSchedulesPerPolicy {
+		return fmt.Errorf("policy_details.0.schedule: a DLM policy may not have more than %d schedules, got %d", dlmMaxSchedulesPerPolicy, len(schedules))
+	}
+
+	for i, rawSchedule := range schedules {
+		schedule, ok := rawSchedule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := fmt.Sprintf("policy_details.0.schedule.%d", i)
+
+		if err := validateDlmCronExpression(path, schedule); err != nil {
+			return err
+		}
+		if err := validateDlmCrossRegionCopyRetention(path, schedule); err != nil {
+			return err
+		}
+		if err := validateDlmArchiveRuleRetention(path, schedule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateDlmPolicyTypeRequiredFields re-requires, at plan time, the fields
+// that EBS_SNAPSHOT_MANAGEMENT and IMAGE_MANAGEMENT policies need but which
+// the schema can't mark Required since EVENT_BASED_POLICY policies don't use
+// them at all.
+func validateDlmPolicyTypeRequiredFields(d *schema.ResourceDiff) error {
+	policyType := d.Get("policy_details.0.policy_type").(string)
+	if policyType == dlm.PolicyTypeValuesEventBasedPolicy {
+		return nil
+	}
+
+	if len(d.Get("policy_details.0.resource_types").([]interface{})) == 0 {
+		return fmt.Errorf("policy_details.0.resource_types is required when policy_details.0.policy_type is %q", policyType)
+	}
+	if len(d.Get("policy_details.0.target_tags").(map[string]interface{})) == 0 {
+		return fmt.Errorf("policy_details.0.target_tags is required when policy_details.0.policy_type is %q", policyType)
+	}
+	if len(d.Get("policy_details.0.schedule").([]interface{})) == 0 {
+		return fmt.Errorf("policy_details.0.schedule is required when policy_details.0.policy_type is %q", policyType)
+	}
+
+	return nil
+}
+
+// validateDlmArchiveRuleRetention ensures a schedule's archive_rule only
+// moves snapshots into the archive tier once the standard retain_rule
+// window has already elapsed. Count-based retain_rule/retention_archive_tier
+// blocks aren't time-comparable, so the check is skipped unless both sides
+// are interval (age) based.
+func validateDlmArchiveRuleRetention(path string, schedule map[string]interface{}) error {
+	archiveRules, _ := schedule["archive_rule"].([]interface{})
+	if len(archiveRules) == 0 || archiveRules[0] == nil {
+		return nil
+	}
+	archiveRule, ok := archiveRules[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	archiveRetainRules, _ := archiveRule["retain_rule"].([]interface{})
+	if len(archiveRetainRules) == 0 || archiveRetainRules[0] == nil {
+		return nil
+	}
+	archiveRetainRule, ok := archiveRetainRules[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	tiers, _ := archiveRetainRule["retention_archive_tier"].([]interface{})
+	if len(tiers) == 0 || tiers[0] == nil {
+		return nil
+	}
+	tier, ok := tiers[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	archiveHours := dlmRetentionIntervalHours(tier["interval"], tier["interval_unit"])
+	if archiveHours == 0 {
+		return nil
+	}
+
+	retainRules, _ := schedule["retain_rule"].([]interface{})
+	if len(retainRules) == 0 || retainRules[0] == nil {
+		return nil
+	}
+	retainRule, ok := retainRules[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	retainHours := dlmRetentionIntervalHours(retainRule["interval"], retainRule["interval_unit"])
+	if retainHours == 0 {
+		return nil
+	}
+
+	if archiveHours < retainHours {
+		return fmt.Errorf("%s.archive_rule.0.retain_rule.0.retention_archive_tier: archive transition (%d hours) must occur at or after the standard retain_rule window ends (%d hours)", path, archiveHours, retainHours)
+	}
+
+	return nil
+}
+
+func validateDlmCronExpression(path string, schedule map[string]interface{}) error {
+	createRules, ok := schedule["create_rule"].([]interface{})
+	if !ok || len(createRules) == 0 || createRules[0] == nil {
+		return nil
+	}
+	createRule, ok := createRules[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	cronExpression, _ := createRule["cron_expression"].(string)
+	if cronExpression == "" {
+		return nil
+	}
+
+	fireTimes, err := dlmCronNextFireTimes(cronExpression, 3)
+	if err != nil {
+		return fmt.Errorf("%s.create_rule.0.cron_expression (%s): %s", path, cronExpression, err)
+	}
+
+	for i := 1; i < len(fireTimes); i++ {
+		gap := fireTimes[i].Sub(fireTimes[i-1])
+		if gap < dlmMinScheduleInterval {
+			return fmt.Errorf("%s.create_rule.0.cron_expression (%s): consecutive snapshots %s apart, DLM requires at least %s between snapshots", path, cronExpression, gap, dlmMinScheduleInterval)
+		}
+	}
+
+	return nil
+}
+
+func validateDlmCrossRegionCopyRetention(path string, schedule map[string]interface{}) error {
+	retainRules, _ := schedule["retain_rule"].([]interface{})
+	var primaryIntervalHours int
+	if len(retainRules) > 0 && retainRules[0] != nil {
+		if retainRule, ok := retainRules[0].(map[string]interface{}); ok {
+			primaryIntervalHours = dlmRetentionIntervalHours(retainRule["interval"], retainRule["interval_unit"])
+		}
+	}
+
+	copyRules, _ := schedule["cross_region_copy_rule"].([]interface{})
+	seenRegions := make(map[string]bool, len(copyRules))
+	for j, rawCopyRule := range copyRules {
+		copyRule, ok := rawCopyRule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		copyRulePath := fmt.Sprintf("%s.cross_region_copy_rule.%d", path, j)
+
+		targetRegion, _ := copyRule["target_region"].(string)
+		if targetRegion != "" {
+			if seenRegions[targetRegion] {
+				return fmt.Errorf("%s.target_region: %q is used by more than one cross_region_copy_rule", copyRulePath, targetRegion)
+			}
+			seenRegions[targetRegion] = true
+		}
+
+		encrypted, _ := copyRule["encrypted"].(bool)
+		cmkArn, _ := copyRule["cmk_arn"].(string)
+		if encrypted && cmkArn == "" {
+			return fmt.Errorf("%s.cmk_arn must be set when encrypted is true", copyRulePath)
+		}
+
+		if primaryIntervalHours > 0 {
+			copyRetainRules, _ := copyRule["retain_rule"].([]interface{})
+			if len(copyRetainRules) > 0 && copyRetainRules[0] != nil {
+				if copyRetainRule, ok := copyRetainRules[0].(map[string]interface{}); ok {
+					copyIntervalHours := dlmRetentionIntervalHours(copyRetainRule["interval"], copyRetainRule["interval_unit"])
+					if copyIntervalHours > 0 && copyIntervalHours < primaryIntervalHours {
+						return fmt.Errorf("%s.retain_rule: cross-region copy retention must be at least as long as the schedule's own retain_rule", copyRulePath)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func dlmRetentionIntervalHours(interval, intervalUnit interface{}) int {
+	n, ok := interval.(int)
+	if !ok || n == 0 {
+		return 0
+	}
+	unit, _ := intervalUnit.(string)
+
+	switch unit {
+	case dlm.RetentionIntervalUnitValuesWeeks:
+		return n * 7 * 24
+	case dlm.RetentionIntervalUnitValuesMonths:
+		return n * 30 * 24
+	case dlm.RetentionIntervalUnitValuesYears:
+		return n * 365 * 24
+	default:
+		return n * 24
+	}
+}
+
+// dlmCronNextFireTimes simulates the 6-field AWS cron expression
+// cron(Minutes Hours Day-of-month Month Day-of-week Year) forward from now
+// and returns up to n fire times. It returns an error if the expression
+// can't be parsed or doesn't fire within dlmCronLookaheadDays.
+func dlmCronNextFireTimes(cronExpression string, n int) ([]time.Time, error) {
+	fields := strings.Fields(strings.TrimSuffix(strings.TrimPrefix(cronExpression, "cron("), ")"))
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("expected 6 fields (Minutes Hours Day-of-month Month Day-of-week Year), got %d", len(fields))
+	}
+
+	minutes, err := parseDlmCronField(fields[0], 0, 59)
+	if err != nil {
+		return nil, fmt.Errorf("minutes: %s", err)
+	}
+	hours, err := parseDlmCronField(fields[1], 0, 23)
+	if err != nil {
+		return nil, fmt.Errorf("hours: %s", err)
+	}
+	doms, domWild, err := parseDlmCronDayField(fields[2], 1, 31)
+	if err != nil {
+		return nil, fmt.Errorf("day-of-month: %s", err)
+	}
+	months, err := parseDlmCronField(fields[3], 1, 12)
+	if err != nil {
+		return nil, fmt.Errorf("month: %s", err)
+	}
+	dows, dowWild, err := parseDlmCronDayField(fields[4], 1, 7)
+	if err != nil {
+		return nil, fmt.Errorf("day-of-week: %s", err)
+	}
+	years, err := parseDlmCronField(fields[5], time.Now().Year(), time.Now().Year()+20)
+	if err != nil {
+		return nil, fmt.Errorf("year: %s", err)
+	}
+
+	if domWild && dowWild {
+		return nil, fmt.Errorf("one of day-of-month or day-of-week must be specified")
+	}
+	if !domWild && !dowWild {
+		return nil, fmt.Errorf("day-of-month and day-of-week can't both be restricted, one must be '?'")
+	}
+
+	now := time.Now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var fireTimes []time.Time
+	for d := 0; d <= dlmCronLookaheadDays && len(fireTimes) < n; d++ {
+		candidate := day.AddDate(0, 0, d)
+		if !years[candidate.Year()] || !months[int(candidate.Month())] {
+			continue
+		}
+		if !domWild && !doms[candidate.Day()] {
+			continue
+		}
+		if !dowWild && !dows[dlmCronWeekday(candidate.Weekday())] {
+			continue
+		}
+
+		for h := 0; h <= 23; h++ {
+			if !hours[h] {
+				continue
+			}
+			for m := 0; m <= 59; m++ {
+				if !minutes[m] {
+					continue
+				}
+				fireTime := time.Date(candidate.Year(), candidate.Month(), candidate.Day(), h, m, 0, 0, time.UTC)
+				if fireTime.Before(now) {
+					continue
+				}
+				fireTimes = append(fireTimes, fireTime)
+				if len(fireTimes) == n {
+					return fireTimes, nil
+				}
+			}
+		}
+	}
+
+	if len(fireTimes) == 0 {
+		return nil, fmt.Errorf("expression does not fire within the next %d days", dlmCronLookaheadDays)
+	}
+
+	return fireTimes, nil
+}
+
+// dlmCronWeekday maps a Go time.Weekday (Sunday=0) to the AWS cron
+// day-of-week convention (SUN=1 .. SAT=7).
+func dlmCronWeekday(w time.Weekday) int {
+	return int(w) + 1
+}
+
+// parseDlmCronDayField parses a day-of-month/day-of-week field, which may be
+// "?" to mean "no specific value" (wild). It returns the allowed-value set,
+// whether the field was wild, and any error.
+func parseDlmCronDayField(field string, min, max int) (map[int]bool, bool, error) {
+	if field == "?" {
+		return nil, true, nil
+	}
+
+	values, err := parseDlmCronField(field, min, max)
+	return values, false, err
+}
+
+// parseDlmCronField parses a single standard cron field (*, */step, a
+// comma-separated list, and a-b ranges) into the set of values it allows.
+func parseDlmCronField(field string, min, max int) (map[int]bool, error) {
+	values := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart := part
+		step := 1
+		hasStep := false
+
+		if idx := strings.Index(part, "/"); idx != -1 {
+			rangePart = part[:idx]
+			s, err := strconv.Atoi(part[idx+1:])
+			if err != nil || s < 1 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			step = s
+			hasStep = true
+		}
+
+		lo, hi := min, max
+		switch {
+		case rangePart == "*":
+			// lo/hi already span the full field range
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			lo, err = strconv.Atoi(bounds[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", bounds[0])
+			}
+			hi, err = strconv.Atoi(bounds[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", bounds[1])
+			}
+		default:
+			v, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", rangePart)
+			}
+			lo = v
+			if hasStep {
+				// A bare "start/step" form (e.g. "0/15") repeats every step
+				// units through the end of the field, not just once at lo.
+				hi = max
+			} else {
+				hi = v
+			}
+		}
+
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("value %q out of range [%d, %d]", part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			values[v] = true
+		}
+	}
+
+	return values, nil
+}
+
+func expandDlmPolicyDetails(cfg []interface{}) *dlm.PolicyDetails {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+
+	policyDetails := &dlm.PolicyDetails{}
+	m := cfg[0].(map[string]interface{})
+
+	policyType := m["policy_type"].(string)
+	policyDetails.PolicyType = aws.String(policyType)
+
+	if policyType == dlm.PolicyTypeValuesEventBasedPolicy {
+		if v, ok := m["event_source"]; ok {
+			policyDetails.EventSource = expandDlmEventSource(v.([]interface{}))
+		}
+		if v, ok := m["action"]; ok {
+			policyDetails.Actions = expandDlmActions(v.([]interface{}))
+		}
+
+		return policyDetails
+	}
+
+	if v, ok := m["resource_types"]; ok {
+		policyDetails.ResourceTypes = expandStringList(v.([]interface{}))
+	}
+	if v, ok := m["schedule"]; ok {
+		policyDetails.Schedules = expandDlmSchedules(v.([]interface{}))
+	}
+	if v, ok := m["target_tags"]; ok {
+		policyDetails.TargetTags = expandDlmTags(v.(map[string]interface{}))
+	}
+
+	return policyDetails
+}
+
+func flattenDlmPolicyDetails(policyDetails *dlm.PolicyDetails) []map[string]interface{} {
+	result := make(map[string]interface{})
+	result["policy_type"] = aws.StringValue(policyDetails.PolicyType)
+
+	if aws.StringValue(policyDetails.PolicyType) == dlm.PolicyTypeValuesEventBasedPolicy {
+		result["event_source"] = flattenDlmEventSource(policyDetails.EventSource)
+		result["action"] = flattenDlmActions(policyDetails.Actions)
+
+		return []map[string]interface{}{result}
+	}
+
+	result["resource_types"] = flattenStringList(policyDetails.ResourceTypes)
+	result["schedule"] = flattenDlmSchedules(policyDetails.Schedules)
+	result["target_tags"] = flattenDlmTags(policyDetails.TargetTags)
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmEventSource(cfg []interface{}) *dlm.EventSource {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	eventSource := &dlm.EventSource{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["parameters"]; ok {
+		params := v.([]interface{})
+		if len(params) > 0 && params[0] != nil {
+			p := params[0].(map[string]interface{})
+			eventSource.Parameters = &dlm.EventParameters{
+				EventType:     aws.String(p["event_type"].(string)),
+				SnapshotOwner: expandStringList(p["snapshot_owner"].([]interface{})),
+			}
+			if v, ok := p["description_regex"]; ok && v.(string) != "" {
+				eventSource.Parameters.DescriptionRegex = aws.String(v.(string))
+			}
+		}
+	}
+
+	return eventSource
+}
+
+func flattenDlmEventSource(eventSource *dlm.EventSource) []map[string]interface{} {
+	if eventSource == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	result["type"] = aws.StringValue(eventSource.Type)
+
+	if eventSource.Parameters != nil {
+		params := make(map[string]interface{})
+		params["description_regex"] = aws.StringValue(eventSource.Parameters.DescriptionRegex)
+		params["event_type"] = aws.StringValue(eventSource.Parameters.EventType)
+		params["snapshot_owner"] = flattenStringList(eventSource.Parameters.SnapshotOwner)
+		result["parameters"] = []map[string]interface{}{params}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmActions(cfg []interface{}) []*dlm.Action {
+	actions := make([]*dlm.Action, len(cfg))
+	for i, c := range cfg {
+		m := c.(map[string]interface{})
+		actions[i] = &dlm.Action{
+			Name:            aws.String(m["name"].(string)),
+			CrossRegionCopy: expandDlmCrossRegionCopyActions(m["cross_region_copy"].([]interface{})),
+		}
+	}
+
+	return actions
+}
+
+func flattenDlmActions(actions []*dlm.Action) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(actions))
+	for i, a := range actions {
+		m := make(map[string]interface{})
+		m["name"] = aws.StringValue(a.Name)
+		m["cross_region_copy"] = flattenDlmCrossRegionCopyActions(a.CrossRegionCopy)
+		result[i] = m
+	}
+
+	return result
+}
+
+func expandDlmCrossRegionCopyActions(cfg []interface{}) []*dlm.CrossRegionCopyAction {
+	actions := make([]*dlm.CrossRegionCopyAction, len(cfg))
+	for i, c := range cfg {
+		m := c.(map[string]interface{})
+		actions[i] = &dlm.CrossRegionCopyAction{
+			EncryptionConfiguration: expandDlmEncryptionConfiguration(m["encryption_configuration"].([]interface{})),
+			RetainRule:              expandDlmCrossRegionCopyRetainRule(m["retain_rule"].([]interface{})),
+			Target:                  aws.String(m["target"].(string)),
+		}
+	}
+
+	return actions
+}
+
+func flattenDlmCrossRegionCopyActions(actions []*dlm.CrossRegionCopyAction) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(actions))
+	for i, a := range actions {
+		m := make(map[string]interface{})
+		m["encryption_configuration"] = flattenDlmEncryptionConfiguration(a.EncryptionConfiguration)
+		m["retain_rule"] = flattenDlmCrossRegionCopyRetainRule(a.RetainRule)
+		m["target"] = aws.StringValue(a.Target)
+		result[i] = m
+	}
+
+	return result
+}
+
+func expandDlmEncryptionConfiguration(cfg []interface{}) *dlm.EncryptionConfiguration {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	encryptionConfiguration := &dlm.EncryptionConfiguration{
+		Encrypted: aws.Bool(m["encrypted"].(bool)),
+	}
+	if v, ok := m["cmk_arn"]; ok && v.(string) != "" {
+		encryptionConfiguration.CmkArn = aws.String(v.(string))
+	}
+
+	return encryptionConfiguration
+}
+
+func flattenDlmEncryptionConfiguration(encryptionConfiguration *dlm.EncryptionConfiguration) []map[string]interface{} {
+	result := make(map[string]interface{})
+	result["cmk_arn"] = aws.StringValue(encryptionConfiguration.CmkArn)
+	result["encrypted"] = aws.BoolValue(encryptionConfiguration.Encrypted)
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmSchedules(cfg []interface{}) []*dlm.Schedule {
+	schedules := make([]*dlm.Schedule, len(cfg))
+	for i, c := range cfg {
+		schedule := &dlm.Schedule{}
+		m := c.(map[string]interface{})
+		if v, ok := m["copy_tags"]; ok {
+			schedule.CopyTags = aws.Bool(v.(bool))
 		}
 		if v, ok := m["create_rule"]; ok {
 			schedule.CreateRule = expandDlmCreateRule(v.([]interface{}))
@@ -366,6 +1431,15 @@ func expandDlmSchedules(cfg []interface{}) []*dlm.Schedule {
 		if v, ok := m["retain_rule"]; ok {
 			schedule.RetainRule = expandDlmRetainRule(v.([]interface{}))
 		}
+		if v, ok := m["archive_rule"]; ok {
+			schedule.ArchiveRule = expandDlmArchiveRule(v.([]interface{}))
+		}
+		if v, ok := m["fast_restore_rule"]; ok {
+			schedule.FastRestoreRule = expandDlmFastRestoreRule(v.([]interface{}))
+		}
+		if v, ok := m["deprecate_rule"]; ok {
+			schedule.DeprecateRule = expandDlmDeprecateRule(v.([]interface{}))
+		}
 		if v, ok := m["tags_to_add"]; ok {
 			schedule.TagsToAdd = expandDlmTags(v.(map[string]interface{}))
 		}
@@ -384,6 +1458,9 @@ func flattenDlmSchedules(schedules []*dlm.Schedule) []map[string]interface{} {
 		m["cross_region_copy_rule"] = flattenDlmCrossRegionCopyRules(s.CrossRegionCopyRules)
 		m["name"] = aws.StringValue(s.Name)
 		m["retain_rule"] = flattenDlmRetainRule(s.RetainRule)
+		m["archive_rule"] = flattenDlmArchiveRule(s.ArchiveRule)
+		m["fast_restore_rule"] = flattenDlmFastRestoreRule(s.FastRestoreRule)
+		m["deprecate_rule"] = flattenDlmDeprecateRule(s.DeprecateRule)
 		m["tags_to_add"] = flattenDlmTags(s.TagsToAdd)
 		result[i] = m
 	}
@@ -474,6 +1551,14 @@ func expandDlmCreateRule(cfg []interface{}) *dlm.CreateRule {
 		}
 	}
 
+	if v, ok := c["no_reboot"]; ok {
+		createRule.NoReboot = aws.Bool(v.(bool))
+	}
+
+	if v, ok := c["script"]; ok {
+		createRule.Scripts = expandDlmScripts(v.([]interface{}))
+	}
+
 	return createRule
 }
 
@@ -493,23 +1578,231 @@ func flattenDlmCreateRule(createRule *dlm.CreateRule) []map[string]interface{} {
 		result["interval_unit"] = aws.StringValue(createRule.IntervalUnit)
 		result["times"] = flattenStringList(createRule.Times)
 	}
+	result["no_reboot"] = aws.BoolValue(createRule.NoReboot)
+	result["script"] = flattenDlmScripts(createRule.Scripts)
 
 	return []map[string]interface{}{result}
 }
 
+func expandDlmScripts(cfg []interface{}) []*dlm.Script {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+
+	scripts := make([]*dlm.Script, len(cfg))
+	for i, c := range cfg {
+		m := c.(map[string]interface{})
+		script := &dlm.Script{
+			ExecutionHandler: aws.String(m["execution_handler"].(string)),
+		}
+		if v, ok := m["execution_handler_service"]; ok {
+			script.ExecutionHandlerService = aws.String(v.(string))
+		}
+		if v, ok := m["execute_operation_on_script_failure"]; ok {
+			script.ExecuteOperationOnScriptFailure = aws.Bool(v.(bool))
+		}
+		if v, ok := m["execution_timeout"]; ok && v.(int) != 0 {
+			script.ExecutionTimeout = aws.Int64(int64(v.(int)))
+		}
+		if v, ok := m["maximum_retry_count"]; ok {
+			script.MaximumRetryCount = aws.Int64(int64(v.(int)))
+		}
+		if v, ok := m["stages"]; ok {
+			script.Stages = expandStringList(v.([]interface{}))
+		}
+		scripts[i] = script
+	}
+
+	return scripts
+}
+
+func flattenDlmScripts(scripts []*dlm.Script) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(scripts))
+	for i, s := range scripts {
+		m := make(map[string]interface{})
+		m["execution_handler"] = aws.StringValue(s.ExecutionHandler)
+		m["execution_handler_service"] = aws.StringValue(s.ExecutionHandlerService)
+		m["execute_operation_on_script_failure"] = aws.BoolValue(s.ExecuteOperationOnScriptFailure)
+		m["execution_timeout"] = aws.Int64Value(s.ExecutionTimeout)
+		m["maximum_retry_count"] = aws.Int64Value(s.MaximumRetryCount)
+		m["stages"] = flattenStringList(s.Stages)
+		result[i] = m
+	}
+
+	return result
+}
+
 func expandDlmRetainRule(cfg []interface{}) *dlm.RetainRule {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
 	}
 	m := cfg[0].(map[string]interface{})
-	return &dlm.RetainRule{
-		Count: aws.Int64(int64(m["count"].(int))),
+
+	retainRule := &dlm.RetainRule{}
+	if v, ok := m["count"]; ok && v.(int) != 0 {
+		retainRule.Count = aws.Int64(int64(v.(int)))
+	} else {
+		retainRule.Interval = aws.Int64(int64(m["interval"].(int)))
+		retainRule.IntervalUnit = aws.String(m["interval_unit"].(string))
 	}
+
+	return retainRule
 }
 
 func flattenDlmRetainRule(retainRule *dlm.RetainRule) []map[string]interface{} {
 	result := make(map[string]interface{})
-	result["count"] = aws.Int64Value(retainRule.Count)
+
+	if aws.Int64Value(retainRule.Count) != 0 {
+		result["count"] = aws.Int64Value(retainRule.Count)
+	} else {
+		result["interval"] = aws.Int64Value(retainRule.Interval)
+		result["interval_unit"] = aws.StringValue(retainRule.IntervalUnit)
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmArchiveRule(cfg []interface{}) *dlm.ArchiveRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	archiveRule := &dlm.ArchiveRule{}
+	if v, ok := m["retain_rule"]; ok {
+		archiveRule.RetainRule = expandDlmArchiveRetainRule(v.([]interface{}))
+	}
+
+	return archiveRule
+}
+
+func flattenDlmArchiveRule(archiveRule *dlm.ArchiveRule) []map[string]interface{} {
+	if archiveRule == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	result["retain_rule"] = flattenDlmArchiveRetainRule(archiveRule.RetainRule)
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmArchiveRetainRule(cfg []interface{}) *dlm.ArchiveRetainRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	archiveRetainRule := &dlm.ArchiveRetainRule{}
+	if v, ok := m["retention_archive_tier"]; ok {
+		archiveRetainRule.RetentionArchiveTier = expandDlmRetentionArchiveTier(v.([]interface{}))
+	}
+
+	return archiveRetainRule
+}
+
+func flattenDlmArchiveRetainRule(archiveRetainRule *dlm.ArchiveRetainRule) []map[string]interface{} {
+	result := make(map[string]interface{})
+	result["retention_archive_tier"] = flattenDlmRetentionArchiveTier(archiveRetainRule.RetentionArchiveTier)
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmRetentionArchiveTier(cfg []interface{}) *dlm.RetentionArchiveTier {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	retentionArchiveTier := &dlm.RetentionArchiveTier{}
+	if v, ok := m["count"]; ok && v.(int) != 0 {
+		retentionArchiveTier.Count = aws.Int64(int64(v.(int)))
+	} else {
+		retentionArchiveTier.Interval = aws.Int64(int64(m["interval"].(int)))
+		retentionArchiveTier.IntervalUnit = aws.String(m["interval_unit"].(string))
+	}
+
+	return retentionArchiveTier
+}
+
+func flattenDlmRetentionArchiveTier(retentionArchiveTier *dlm.RetentionArchiveTier) []map[string]interface{} {
+	result := make(map[string]interface{})
+
+	if aws.Int64Value(retentionArchiveTier.Count) != 0 {
+		result["count"] = aws.Int64Value(retentionArchiveTier.Count)
+	} else {
+		result["interval"] = aws.Int64Value(retentionArchiveTier.Interval)
+		result["interval_unit"] = aws.StringValue(retentionArchiveTier.IntervalUnit)
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmFastRestoreRule(cfg []interface{}) *dlm.FastRestoreRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	fastRestoreRule := &dlm.FastRestoreRule{
+		AvailabilityZones: expandStringList(m["availability_zones"].([]interface{})),
+	}
+	if v, ok := m["count"]; ok && v.(int) != 0 {
+		fastRestoreRule.Count = aws.Int64(int64(v.(int)))
+	} else {
+		fastRestoreRule.Interval = aws.Int64(int64(m["interval"].(int)))
+		fastRestoreRule.IntervalUnit = aws.String(m["interval_unit"].(string))
+	}
+
+	return fastRestoreRule
+}
+
+func flattenDlmFastRestoreRule(fastRestoreRule *dlm.FastRestoreRule) []map[string]interface{} {
+	if fastRestoreRule == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	result["availability_zones"] = flattenStringList(fastRestoreRule.AvailabilityZones)
+	if aws.Int64Value(fastRestoreRule.Count) != 0 {
+		result["count"] = aws.Int64Value(fastRestoreRule.Count)
+	} else {
+		result["interval"] = aws.Int64Value(fastRestoreRule.Interval)
+		result["interval_unit"] = aws.StringValue(fastRestoreRule.IntervalUnit)
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmDeprecateRule(cfg []interface{}) *dlm.DeprecateRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	deprecateRule := &dlm.DeprecateRule{}
+	if v, ok := m["count"]; ok && v.(int) != 0 {
+		deprecateRule.Count = aws.Int64(int64(v.(int)))
+	} else {
+		deprecateRule.Interval = aws.Int64(int64(m["interval"].(int)))
+		deprecateRule.IntervalUnit = aws.String(m["interval_unit"].(string))
+	}
+
+	return deprecateRule
+}
+
+func flattenDlmDeprecateRule(deprecateRule *dlm.DeprecateRule) []map[string]interface{} {
+	if deprecateRule == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	if aws.Int64Value(deprecateRule.Count) != 0 {
+		result["count"] = aws.Int64Value(deprecateRule.Count)
+	} else {
+		result["interval"] = aws.Int64Value(deprecateRule.Interval)
+		result["interval_unit"] = aws.StringValue(deprecateRule.IntervalUnit)
+	}
 
 	return []map[string]interface{}{result}
 }
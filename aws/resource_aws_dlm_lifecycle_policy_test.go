@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDlmLifecyclePolicy_scriptVssBackup(t *testing.T) {
+	var policy dlm.LifecyclePolicy
+	resourceName := "aws_dlm_lifecycle_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDlmLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDlmLifecyclePolicyConfigScript(rName, "AWS_VSS_BACKUP"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDlmLifecyclePolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.create_rule.0.script.0.execution_handler", "AWS_VSS_BACKUP"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.create_rule.0.script.0.stages.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDlmLifecyclePolicy_scriptSapHana(t *testing.T) {
+	var policy dlm.LifecyclePolicy
+	resourceName := "aws_dlm_lifecycle_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDlmLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDlmLifecyclePolicyConfigScript(rName, "AWSSystemsManagerSAP-CreateDLMSnapshotForSAPHANA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDlmLifecyclePolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.create_rule.0.script.0.execution_handler", "AWSSystemsManagerSAP-CreateDLMSnapshotForSAPHANA"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.schedule.0.create_rule.0.script.0.execute_operation_on_script_failure", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDlmLifecyclePolicyConfigScript(rName, executionHandler string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "dlm_lifecycle_role" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "dlm.amazonaws.com"
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_dlm_lifecycle_policy" "test" {
+  description         = %[1]q
+  execution_role_arn  = aws_iam_role.dlm_lifecycle_role.arn
+  state               = "ENABLED"
+
+  policy_details {
+    resource_types = ["INSTANCE"]
+
+    schedule {
+      name = "script schedule"
+
+      create_rule {
+        interval      = 12
+        interval_unit = "HOURS"
+
+        script {
+          execution_handler                  = %[2]q
+          execute_operation_on_script_failure = true
+          execution_timeout                  = 60
+          maximum_retry_count                 = 2
+          stages                             = ["PRE", "POST"]
+        }
+      }
+
+      retain_rule {
+        count = 3
+      }
+    }
+
+    target_tags = {
+      Snapshot = "true"
+    }
+  }
+}
+`, rName, executionHandler)
+}